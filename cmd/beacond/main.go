@@ -38,12 +38,25 @@ func run() error {
 		return err
 	}
 
+	// Build the chain spec so its fork schedule can be passed to the node
+	// builder below instead of the node hardcoding a single active fork. The
+	// CLI builder builds its own spec through spec.Create.
+	chainSpec, err := spec.Create()
+	if err != nil {
+		return err
+	}
+
 	// Build the node using the node-core.
 	nb := nodebuilder.New(
 		// Set the Runtime Components to the Default.
 		nodebuilder.WithComponents(
 			DefaultComponents(),
 		),
+		// Set the ChainSpec to the one built above, so adding a fork is a
+		// spec edit rather than a change across the module.
+		nodebuilder.WithChainSpec(
+			chainSpec,
+		),
 	)
 
 	// Build the root command using the builder
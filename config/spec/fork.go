@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import "github.com/berachain/beacon-kit/primitives/common"
+
+// Fork names, in activation order. Mirrors the Ethereum consensus fork
+// schedule; new forks are appended here, not hardcoded into consumers.
+const (
+	NameGenesis   = "genesis"
+	NameAltair    = "altair"
+	NameBellatrix = "bellatrix"
+	NameCapella   = "capella"
+	NameDeneb     = "deneb"
+	NameElectra   = "electra"
+)
+
+// Fork describes a single named fork in the chain's fork schedule: the
+// version used in ForkDigest / domain computation, and the epoch at which it
+// activates.
+type Fork struct {
+	// Name is the human-readable fork name (e.g. "deneb").
+	Name string
+	// Version is the fork version used in signing domains and ForkDigest.
+	Version common.Version
+	// Epoch is the epoch at which this fork activates.
+	Epoch uint64
+}
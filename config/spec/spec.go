@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package spec defines the chain spec: the fork schedule and related
+// parameters that every other component builds against, so that adding a
+// fork is a spec edit rather than a change scattered across the module.
+package spec
+
+import (
+	"sort"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// ErrForksNotSorted is returned when a Spec is constructed from a fork
+// schedule that is not in non-decreasing activation-epoch order (ties are
+// allowed, e.g. several forks all activating at genesis).
+var ErrForksNotSorted = errors.New("spec: forks must be sorted by ascending epoch")
+
+// ErrNoForks is returned when a Spec is constructed with no forks at all.
+var ErrNoForks = errors.New("spec: at least one fork is required")
+
+// ErrNoGenesisFork is returned when the first fork in the schedule does not
+// activate at epoch 0.
+var ErrNoGenesisFork = errors.New("spec: first fork must activate at epoch 0")
+
+// ErrZeroSlotsPerEpoch is returned when a Spec is constructed with a
+// slotsPerEpoch of 0.
+var ErrZeroSlotsPerEpoch = errors.New("spec: slotsPerEpoch must be non-zero")
+
+// Spec is the chain spec: an ordered fork schedule plus the parameters
+// needed to resolve a fork from an epoch or slot.
+type Spec struct {
+	// forks is sorted by ascending Epoch; forks[0] is always the genesis
+	// fork.
+	forks []Fork
+
+	// slotsPerEpoch is the number of slots in a single epoch.
+	slotsPerEpoch uint64
+}
+
+// NewSpec constructs a Spec from forks, which must be sorted by ascending
+// activation epoch and start with a fork activating at epoch 0.
+func NewSpec(forks []Fork, slotsPerEpoch uint64) (*Spec, error) {
+	if len(forks) == 0 {
+		return nil, ErrNoForks
+	}
+	if forks[0].Epoch != 0 {
+		return nil, errors.Wrapf(
+			ErrNoGenesisFork, "fork %q activates at epoch %d", forks[0].Name, forks[0].Epoch,
+		)
+	}
+	if slotsPerEpoch == 0 {
+		return nil, ErrZeroSlotsPerEpoch
+	}
+	for i := 1; i < len(forks); i++ {
+		if forks[i].Epoch < forks[i-1].Epoch {
+			return nil, errors.Wrapf(
+				ErrForksNotSorted, "fork %q at epoch %d precedes %q at epoch %d",
+				forks[i].Name, forks[i].Epoch, forks[i-1].Name, forks[i-1].Epoch,
+			)
+		}
+	}
+
+	sorted := make([]Fork, len(forks))
+	copy(sorted, forks)
+	return &Spec{forks: sorted, slotsPerEpoch: slotsPerEpoch}, nil
+}
+
+// Forks returns the fork schedule, sorted by ascending activation epoch.
+func (s *Spec) Forks() []Fork {
+	return s.forks
+}
+
+// SlotsPerEpoch returns the number of slots per epoch.
+func (s *Spec) SlotsPerEpoch() uint64 {
+	return s.slotsPerEpoch
+}
+
+// ForkAtEpoch returns the fork active at epoch: the latest fork whose
+// activation epoch is less than or equal to epoch.
+func (s *Spec) ForkAtEpoch(epoch uint64) Fork {
+	i := sort.Search(len(s.forks), func(i int) bool {
+		return s.forks[i].Epoch > epoch
+	})
+	return s.forks[i-1]
+}
+
+// ForkAtSlot returns the fork active at slot.
+func (s *Spec) ForkAtSlot(slot uint64) Fork {
+	return s.ForkAtEpoch(slot / s.slotsPerEpoch)
+}
+
+// ForkDigest computes the ForkDigest for the fork active at epoch.
+func (s *Spec) ForkDigest(epoch uint64, genesisValidatorsRoot common.Root) common.ForkDigest {
+	fork := s.ForkAtEpoch(epoch)
+	return common.ComputeForkDigest(fork.Version, genesisValidatorsRoot)
+}
+
+// Create builds the default chain spec used by beacond. It is the
+// ChainSpecBuilderFunc wired into the CLI builder.
+func Create() (*Spec, error) {
+	return NewSpec([]Fork{
+		{Name: NameGenesis, Version: common.Version{0x00, 0x00, 0x00, 0x00}, Epoch: 0},
+		{Name: NameAltair, Version: common.Version{0x01, 0x00, 0x00, 0x00}, Epoch: 0},
+		{Name: NameBellatrix, Version: common.Version{0x02, 0x00, 0x00, 0x00}, Epoch: 0},
+		{Name: NameCapella, Version: common.Version{0x03, 0x00, 0x00, 0x00}, Epoch: 0},
+		{Name: NameDeneb, Version: common.Version{0x04, 0x00, 0x00, 0x00}, Epoch: 0},
+		{Name: NameElectra, Version: common.Version{0x05, 0x00, 0x00, 0x00}, Epoch: 0},
+	}, 32)
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+func TestNewSpecRejectsNonGenesisFirstFork(t *testing.T) {
+	_, err := spec.NewSpec([]spec.Fork{
+		{Name: spec.NameGenesis, Version: common.Version{}, Epoch: 1},
+	}, 32)
+	if err == nil {
+		t.Fatal("expected an error when the first fork does not activate at epoch 0")
+	}
+}
+
+func TestNewSpecRejectsZeroSlotsPerEpoch(t *testing.T) {
+	_, err := spec.NewSpec([]spec.Fork{
+		{Name: spec.NameGenesis, Version: common.Version{}, Epoch: 0},
+	}, 0)
+	if err == nil {
+		t.Fatal("expected an error for a zero slotsPerEpoch")
+	}
+}
+
+func TestForkAtEpochActivationBoundaries(t *testing.T) {
+	s, err := spec.NewSpec([]spec.Fork{
+		{Name: spec.NameGenesis, Version: common.Version{}, Epoch: 0},
+		{Name: spec.NameAltair, Version: common.Version{0x01}, Epoch: 10},
+	}, 32)
+	if err != nil {
+		t.Fatalf("NewSpec: %v", err)
+	}
+
+	fork := s.ForkAtEpoch(0)
+	if fork.Name != spec.NameGenesis {
+		t.Fatalf("got fork %q at epoch 0, want %q", fork.Name, spec.NameGenesis)
+	}
+
+	fork = s.ForkAtEpoch(10)
+	if fork.Name != spec.NameAltair {
+		t.Fatalf("got fork %q at epoch 10, want %q", fork.Name, spec.NameAltair)
+	}
+}
+
+func TestForkAtSlot(t *testing.T) {
+	s, err := spec.NewSpec([]spec.Fork{
+		{Name: spec.NameGenesis, Version: common.Version{}, Epoch: 0},
+		{Name: spec.NameAltair, Version: common.Version{0x01}, Epoch: 1},
+	}, 32)
+	if err != nil {
+		t.Fatalf("NewSpec: %v", err)
+	}
+
+	if fork := s.ForkAtSlot(31); fork.Name != spec.NameGenesis {
+		t.Fatalf("got fork %q at slot 31, want %q", fork.Name, spec.NameGenesis)
+	}
+	if fork := s.ForkAtSlot(32); fork.Name != spec.NameAltair {
+		t.Fatalf("got fork %q at slot 32, want %q", fork.Name, spec.NameAltair)
+	}
+}
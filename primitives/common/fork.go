@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package common
+
+import "crypto/sha256"
+
+// ComputeForkDataRoot implements the SSZ hash tree root of the ForkData
+// container:
+//
+//	class ForkData(Container):
+//	    current_version: Version
+//	    genesis_validators_root: Root
+//
+// Each field fits in a single 32-byte SSZ chunk, so its hash tree root is
+// SHA256(current_version zero-padded to 32 bytes || genesis_validators_root).
+// This is the shared primitive behind both ComputeForkDigest and
+// primitives/ssz.ComputeDomain, which must agree on the same fork data root.
+func ComputeForkDataRoot(version Version, genesisValidatorsRoot Root) Root {
+	var versionLeaf Root
+	copy(versionLeaf[:], version[:])
+
+	h := sha256.New()
+	h.Write(versionLeaf[:])
+	h.Write(genesisValidatorsRoot[:])
+
+	var root Root
+	copy(root[:], h.Sum(nil))
+	return root
+}
+
+// ComputeForkDigest implements the Ethereum consensus ForkDigest derivation:
+//
+//	fork_digest = compute_fork_data_root(version, genesis_validators_root)[:4]
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_digest
+func ComputeForkDigest(version Version, genesisValidatorsRoot Root) ForkDigest {
+	forkDataRoot := ComputeForkDataRoot(version, genesisValidatorsRoot)
+
+	var digest ForkDigest
+	copy(digest[:], forkDataRoot[:len(digest)])
+	return digest
+}
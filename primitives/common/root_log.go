@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package common
+
+import (
+	"encoding/base64"
+	stdhex "encoding/hex"
+	"log/slog"
+
+	"github.com/berachain/beacon-kit/primitives/bytes"
+)
+
+// RootLogFormat selects how Root.LogValue renders a root for structured
+// logging.
+type RootLogFormat uint8
+
+const (
+	// FullHex renders the full 66-character "0x"-prefixed hex string. This
+	// is the default, for backward compatibility with existing log
+	// consumers.
+	FullHex RootLogFormat = iota
+	// ShortHex renders a short form keeping only the first and last 4
+	// bytes, e.g. "0x69696969…69696969".
+	ShortHex
+	// Base64 renders the standard base64 encoding of the raw 32 bytes.
+	Base64
+)
+
+// rootLogFormat is the package-wide format used by Root.LogValue. It is not
+// safe to mutate concurrently with logging; set it once during startup.
+var rootLogFormat = FullHex
+
+// SetRootLogFormat sets the format used by Root.LogValue for all roots
+// logged via slog. Operators running high-throughput logging pipelines can
+// pick ShortHex or Base64 to cut down on log volume and allocations.
+func SetRootLogFormat(format RootLogFormat) {
+	rootLogFormat = format
+}
+
+// LogValue implements slog.LogValuer, so that slog call sites logging a Root
+// get a value shaped by the current RootLogFormat instead of always paying
+// for the full hex string.
+func (r Root) LogValue() slog.Value {
+	switch rootLogFormat {
+	case ShortHex:
+		return slog.StringValue(r.shortHex())
+	case Base64:
+		return slog.StringValue(base64.StdEncoding.EncodeToString(r[:]))
+	case FullHex:
+		fallthrough
+	default:
+		return slog.StringValue(r.Hex())
+	}
+}
+
+// shortHex renders r keeping only its first and last 4 bytes, without
+// hex-encoding the 28 bytes in between.
+func (r Root) shortHex() string {
+	const n = 4
+	var prefix, suffix [2 * n]byte
+	stdhex.Encode(prefix[:], r[:n])
+	stdhex.Encode(suffix[:], r[RootSize-n:])
+	return "0x" + string(prefix[:]) + "…" + string(suffix[:])
+}
+
+// MarshalBinary returns the raw 32 bytes of r, with no hex encoding.
+func (r Root) MarshalBinary() ([]byte, error) {
+	out := make([]byte, RootSize)
+	copy(out, r[:])
+	return out, nil
+}
+
+// UnmarshalBinary parses the raw 32 bytes of r, with no hex decoding.
+func (r *Root) UnmarshalBinary(data []byte) error {
+	if len(data) != RootSize {
+		return bytes.ErrIncorrectLength
+	}
+	copy(r[:], data)
+	return nil
+}
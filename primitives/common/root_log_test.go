@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package common_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+var benchRoot = common.Root{
+	0x69, 0x69, 0x69, 0x69, 0x69, 0x69, 0x69, 0x69,
+	0x69, 0x69, 0x69, 0x69, 0x69, 0x69, 0x69, 0x69,
+	0x69, 0x69, 0x69, 0x69, 0x69, 0x69, 0x69, 0x69,
+	0x69, 0x69, 0x69, 0x69, 0x69, 0x69, 0x69, 0x69,
+}
+
+// TestRootLogValueShortHexAllocsNoMoreThanFullHex checks that the short-form
+// log path allocates no more often than the full-hex path.
+func TestRootLogValueShortHexAllocsNoMoreThanFullHex(t *testing.T) {
+	defer common.SetRootLogFormat(common.FullHex)
+
+	common.SetRootLogFormat(common.FullHex)
+	fullAllocs := testing.AllocsPerRun(100, func() {
+		_ = benchRoot.LogValue()
+	})
+
+	common.SetRootLogFormat(common.ShortHex)
+	shortAllocs := testing.AllocsPerRun(100, func() {
+		_ = benchRoot.LogValue()
+	})
+
+	if shortAllocs > fullAllocs {
+		t.Fatalf(
+			"expected ShortHex allocs (%v) <= FullHex allocs (%v)", shortAllocs, fullAllocs,
+		)
+	}
+}
+
+func BenchmarkRootLogValueFullHex(b *testing.B) {
+	common.SetRootLogFormat(common.FullHex)
+	defer common.SetRootLogFormat(common.FullHex)
+
+	b.ReportAllocs()
+	for range b.N {
+		_ = benchRoot.LogValue()
+	}
+}
+
+func BenchmarkRootLogValueShortHex(b *testing.B) {
+	common.SetRootLogFormat(common.ShortHex)
+	defer common.SetRootLogFormat(common.FullHex)
+
+	b.ReportAllocs()
+	for range b.N {
+		_ = benchRoot.LogValue()
+	}
+}
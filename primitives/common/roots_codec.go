@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package common
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+// rootsCountSize is the width, in bytes, of the big-endian count prefix used
+// by MarshalRoots / UnmarshalRoots / RootEncoder / RootDecoder.
+const rootsCountSize = 4
+
+// maxRootsCount bounds the number of roots UnmarshalRoots and RootDecoder
+// will allocate for, guarding against a corrupt or malicious count prefix
+// forcing an unbounded allocation. Override via SetMaxRootsCount.
+var maxRootsCount uint32 = 1 << 20
+
+// SetMaxRootsCount overrides the maximum roots count accepted by
+// UnmarshalRoots, UnmarshalRootsJSON, and RootDecoder.
+func SetMaxRootsCount(max uint32) {
+	maxRootsCount = max
+}
+
+var (
+	// ErrRootsCountTooLarge is returned when a roots payload's count prefix
+	// exceeds maxRootsCount.
+	ErrRootsCountTooLarge = errors.New("common: roots count exceeds maximum")
+
+	// ErrTruncatedRoots is returned when a roots payload's length does not
+	// match its count prefix: too short, or with trailing bytes.
+	ErrTruncatedRoots = errors.New("common: truncated roots payload")
+)
+
+// MarshalRoots encodes roots as a 4-byte big-endian count followed by
+// count*RootSize raw bytes. This is the framing used for block-body /
+// historical-roots endpoints and P2P gossip, which carry large []Root
+// payloads and would otherwise pay for a fresh hex string per root.
+func MarshalRoots(roots []Root) ([]byte, error) {
+	if uint32(len(roots)) > maxRootsCount {
+		return nil, errors.Wrapf(
+			ErrRootsCountTooLarge, "count %d exceeds maximum %d", len(roots), maxRootsCount,
+		)
+	}
+
+	out := make([]byte, rootsCountSize+len(roots)*RootSize)
+	binary.BigEndian.PutUint32(out, uint32(len(roots)))
+	for i, root := range roots {
+		copy(out[rootsCountSize+i*RootSize:], root[:])
+	}
+	return out, nil
+}
+
+// UnmarshalRoots decodes the framing produced by MarshalRoots.
+func UnmarshalRoots(data []byte) ([]Root, error) {
+	if len(data) < rootsCountSize {
+		return nil, errors.Wrapf(
+			ErrTruncatedRoots, "input length (%d) is too small for the count prefix", len(data),
+		)
+	}
+
+	count := binary.BigEndian.Uint32(data)
+	if count > maxRootsCount {
+		return nil, errors.Wrapf(
+			ErrRootsCountTooLarge, "count %d exceeds maximum %d", count, maxRootsCount,
+		)
+	}
+
+	want := rootsCountSize + int(count)*RootSize
+	if len(data) != want {
+		return nil, errors.Wrapf(
+			ErrTruncatedRoots, "expected %d bytes for %d roots, got %d", want, count, len(data),
+		)
+	}
+
+	roots := make([]Root, count)
+	for i := range roots {
+		copy(roots[i][:], data[rootsCountSize+i*RootSize:rootsCountSize+(i+1)*RootSize])
+	}
+	return roots, nil
+}
+
+// MarshalRootsJSON encodes roots as a single JSON hex string over the
+// concatenated raw bytes, avoiding the per-element quoted-hex overhead that
+// json.Marshal([]Root) forces through Root.MarshalJSON.
+func MarshalRootsJSON(roots []Root) ([]byte, error) {
+	raw := make([]byte, len(roots)*RootSize)
+	for i, root := range roots {
+		copy(raw[i*RootSize:], root[:])
+	}
+	return json.Marshal(hex.EncodeBytes(raw))
+}
+
+// UnmarshalRootsJSON parses the encoding produced by MarshalRootsJSON.
+func UnmarshalRootsJSON(data []byte) ([]Root, error) {
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, err
+	}
+
+	raw, err := hex.ToBytes(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw)%RootSize != 0 {
+		return nil, errors.Wrapf(
+			bytes.ErrIncorrectLength, "raw length (%d) is not a multiple of %d", len(raw), RootSize,
+		)
+	}
+
+	count := len(raw) / RootSize
+	if uint32(count) > maxRootsCount {
+		return nil, errors.Wrapf(
+			ErrRootsCountTooLarge, "count %d exceeds maximum %d", count, maxRootsCount,
+		)
+	}
+
+	roots := make([]Root, count)
+	for i := range roots {
+		copy(roots[i][:], raw[i*RootSize:(i+1)*RootSize])
+	}
+	return roots, nil
+}
+
+// RootEncoder writes a stream of []Root frames to an io.Writer, each framed
+// the same way as MarshalRoots.
+type RootEncoder struct {
+	w io.Writer
+}
+
+// NewRootEncoder returns a RootEncoder writing frames to w.
+func NewRootEncoder(w io.Writer) *RootEncoder {
+	return &RootEncoder{w: w}
+}
+
+// Encode writes roots as a single length-prefixed frame.
+func (e *RootEncoder) Encode(roots []Root) error {
+	data, err := MarshalRoots(roots)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(data)
+	return err
+}
+
+// RootDecoder reads a stream of []Root frames from an io.Reader, each framed
+// the same way as MarshalRoots.
+type RootDecoder struct {
+	r io.Reader
+}
+
+// NewRootDecoder returns a RootDecoder reading frames from r.
+func NewRootDecoder(r io.Reader) *RootDecoder {
+	return &RootDecoder{r: r}
+}
+
+// Decode reads and returns the next length-prefixed frame of roots.
+func (d *RootDecoder) Decode() ([]Root, error) {
+	var countBuf [rootsCountSize]byte
+	if _, err := io.ReadFull(d.r, countBuf[:]); err != nil {
+		return nil, err
+	}
+
+	count := binary.BigEndian.Uint32(countBuf[:])
+	if count > maxRootsCount {
+		return nil, errors.Wrapf(
+			ErrRootsCountTooLarge, "count %d exceeds maximum %d", count, maxRootsCount,
+		)
+	}
+
+	roots := make([]Root, count)
+	for i := range roots {
+		if _, err := io.ReadFull(d.r, roots[i][:]); err != nil {
+			return nil, err
+		}
+	}
+	return roots, nil
+}
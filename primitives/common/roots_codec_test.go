@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package common_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// FuzzUnmarshalRoots exercises UnmarshalRoots against truncated inputs and
+// oversized counts: it must never panic, and any payload it accepts must
+// round-trip through MarshalRoots.
+func FuzzUnmarshalRoots(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0x00, 0x00, 0x00, 0x00})
+	f.Add([]byte{0x00, 0x00, 0x00, 0x01})
+	f.Add(append(binary.BigEndian.AppendUint32(nil, 1), make([]byte, common.RootSize)...))
+	f.Add(binary.BigEndian.AppendUint32(nil, 1<<21))
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		roots, err := common.UnmarshalRoots(data)
+		if err != nil {
+			return
+		}
+
+		reencoded, err := common.MarshalRoots(roots)
+		if err != nil {
+			t.Fatalf("MarshalRoots on accepted roots failed: %v", err)
+		}
+		if string(reencoded) != string(data) {
+			t.Fatalf("round-trip mismatch: got %x, want %x", reencoded, data)
+		}
+	})
+}
+
+func TestUnmarshalRootsRejectsOversizedCount(t *testing.T) {
+	defer common.SetMaxRootsCount(1 << 20)
+	common.SetMaxRootsCount(2)
+
+	data := binary.BigEndian.AppendUint32(nil, 3)
+	if _, err := common.UnmarshalRoots(data); err == nil {
+		t.Fatal("expected an error for a count exceeding the configured maximum")
+	}
+}
+
+func TestUnmarshalRootsRejectsTruncatedPayload(t *testing.T) {
+	data := binary.BigEndian.AppendUint32(nil, 1)
+	if _, err := common.UnmarshalRoots(data); err == nil {
+		t.Fatal("expected an error for a payload missing its root bytes")
+	}
+}
+
+func TestRootsRoundTrip(t *testing.T) {
+	roots := []common.Root{{0x01}, {0x02}, {0x03}}
+
+	encoded, err := common.MarshalRoots(roots)
+	if err != nil {
+		t.Fatalf("MarshalRoots: %v", err)
+	}
+	decoded, err := common.UnmarshalRoots(encoded)
+	if err != nil {
+		t.Fatalf("UnmarshalRoots: %v", err)
+	}
+	if len(decoded) != len(roots) {
+		t.Fatalf("got %d roots, want %d", len(decoded), len(roots))
+	}
+	for i := range roots {
+		if !decoded[i].Equals(roots[i]) {
+			t.Fatalf("root %d mismatch: got %s, want %s", i, decoded[i], roots[i])
+		}
+	}
+
+	jsonEncoded, err := common.MarshalRootsJSON(roots)
+	if err != nil {
+		t.Fatalf("MarshalRootsJSON: %v", err)
+	}
+	jsonDecoded, err := common.UnmarshalRootsJSON(jsonEncoded)
+	if err != nil {
+		t.Fatalf("UnmarshalRootsJSON: %v", err)
+	}
+	if len(jsonDecoded) != len(roots) {
+		t.Fatalf("got %d roots, want %d", len(jsonDecoded), len(roots))
+	}
+	for i := range roots {
+		if !jsonDecoded[i].Equals(roots[i]) {
+			t.Fatalf("root %d mismatch: got %s, want %s", i, jsonDecoded[i], roots[i])
+		}
+	}
+}
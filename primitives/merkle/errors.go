@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrInvalidProof is returned when a (multi)proof does not derive the
+	// expected root.
+	ErrInvalidProof = errors.New("merkle: invalid proof")
+
+	// ErrMismatchedLengths is returned when indices and leaves passed to
+	// VerifyMultiProof do not have matching lengths.
+	ErrMismatchedLengths = errors.New("merkle: mismatched indices and leaves length")
+
+	// ErrMismatchedBranchLength is returned when the supplied branch does
+	// not contain exactly as many nodes as the proof requires.
+	ErrMismatchedBranchLength = errors.New("merkle: mismatched branch length")
+
+	// ErrNotPowerOfTwo is returned when a tree is built from a leaf slice
+	// whose length is not a power of two.
+	ErrNotPowerOfTwo = errors.New("merkle: leaf count must be a power of two")
+
+	// ErrIndexOutOfRange is returned when a generalized index falls outside
+	// the bounds of the tree it is being resolved against.
+	ErrIndexOutOfRange = errors.New("merkle: generalized index out of range")
+)
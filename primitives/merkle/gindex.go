@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import "sort"
+
+// A generalized index identifies a node in a binary Merkle tree: the root is
+// 1, and the children of node i are 2i (left) and 2i+1 (right). See
+// https://github.com/ethereum/consensus-specs/blob/dev/ssz/merkle-proofs.md.
+
+// siblingIndex returns the generalized index of index's sibling.
+func siblingIndex(index uint64) uint64 {
+	return index ^ 1
+}
+
+// parentIndex returns the generalized index of index's parent.
+func parentIndex(index uint64) uint64 {
+	return index / 2
+}
+
+// pathIndices returns the generalized indices of every ancestor of index,
+// starting with index itself, up to (but excluding) the root.
+func pathIndices(index uint64) []uint64 {
+	path := []uint64{index}
+	for path[len(path)-1] > 1 {
+		path = append(path, parentIndex(path[len(path)-1]))
+	}
+	return path[:len(path)-1]
+}
+
+// branchIndices returns the generalized indices of the sibling nodes needed
+// to prove index, from leaf to root.
+func branchIndices(index uint64) []uint64 {
+	branch := []uint64{siblingIndex(index)}
+	for branch[len(branch)-1] > 1 {
+		branch = append(branch, siblingIndex(parentIndex(branch[len(branch)-1])))
+	}
+	return branch[:len(branch)-1]
+}
+
+// helperIndices returns the minimal, deduplicated set of generalized indices
+// (sorted in descending order) whose values must be supplied out-of-band to
+// reconstruct the root given only the leaves at indices.
+func helperIndices(indices []uint64) []uint64 {
+	allHelpers := make(map[uint64]struct{})
+	allPath := make(map[uint64]struct{})
+	for _, index := range indices {
+		for _, h := range branchIndices(index) {
+			allHelpers[h] = struct{}{}
+		}
+		for _, p := range pathIndices(index) {
+			allPath[p] = struct{}{}
+		}
+	}
+
+	helpers := make([]uint64, 0, len(allHelpers))
+	for h := range allHelpers {
+		if _, onPath := allPath[h]; !onPath {
+			helpers = append(helpers, h)
+		}
+	}
+	sort.Slice(helpers, func(i, j int) bool { return helpers[i] > helpers[j] })
+	return helpers
+}
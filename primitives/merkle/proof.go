@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	stdbytes "bytes"
+	"crypto/sha256"
+	"sort"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// hashPair returns SHA256(left || right).
+func hashPair(left, right Value) Value {
+	h := sha256.New()
+	h.Write(left[:])
+	h.Write(right[:])
+	return NewValueFromBytes(h.Sum(nil))
+}
+
+// VerifyProof verifies that leaf, combined with branch, hashes up to root at
+// the given generalized index. branch must contain exactly one sibling per
+// level, ordered from leaf to root.
+func VerifyProof(root common.Root, index uint64, branch []Value, leaf Value) error {
+	node := leaf
+	for _, sibling := range branch {
+		if index&1 == 1 {
+			node = hashPair(sibling, node)
+		} else {
+			node = hashPair(node, sibling)
+		}
+		index = parentIndex(index)
+	}
+	if !stdbytes.Equal(node[:], root[:]) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// VerifyMultiProof verifies that leaves, combined with branch, hash up to
+// root at the given generalized indices. branch must contain exactly the
+// helper nodes returned by helperIndices(indices), i.e. the standard SSZ
+// multiproof reconstruction: known nodes are keyed by generalized index,
+// missing siblings are pulled from branch in descending-index order.
+func VerifyMultiProof(root common.Root, indices []uint64, branch []Value, leaves []Value) error {
+	if len(indices) != len(leaves) {
+		return errors.Wrapf(
+			ErrMismatchedLengths, "got %d indices and %d leaves", len(indices), len(leaves),
+		)
+	}
+
+	helpers := helperIndices(indices)
+	if len(branch) != len(helpers) {
+		return errors.Wrapf(
+			ErrMismatchedBranchLength,
+			"expected a branch of length %d, got %d", len(helpers), len(branch),
+		)
+	}
+
+	nodes := make(map[uint64]Value, len(indices)+len(helpers))
+	for i, index := range indices {
+		nodes[index] = leaves[i]
+	}
+	for i, helper := range helpers {
+		nodes[helper] = branch[i]
+	}
+
+	keys := make([]uint64, 0, len(nodes))
+	for k := range nodes {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] > keys[j] })
+
+	for pos := 0; pos < len(keys); pos++ {
+		k := keys[pos]
+		if k <= 1 {
+			continue
+		}
+		sibling := siblingIndex(k)
+		parent := parentIndex(k)
+		if _, ok := nodes[parent]; ok {
+			continue
+		}
+		node, haveNode := nodes[k]
+		siblingNode, haveSibling := nodes[sibling]
+		if !haveNode || !haveSibling {
+			continue
+		}
+
+		var left, right Value
+		if k%2 == 0 {
+			left, right = node, siblingNode
+		} else {
+			left, right = siblingNode, node
+		}
+		nodes[parent] = hashPair(left, right)
+		keys = append(keys, parent)
+	}
+
+	computed, ok := nodes[1]
+	if !ok || !stdbytes.Equal(computed[:], root[:]) {
+		return ErrInvalidProof
+	}
+	return nil
+}
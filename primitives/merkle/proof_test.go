@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+)
+
+// testLeaves returns n distinct leaves, each derived from its index so
+// tampering is easy to reason about.
+func testLeaves(n int) []merkle.Value {
+	leaves := make([]merkle.Value, n)
+	for i := range leaves {
+		leaves[i][0] = byte(i + 1)
+		leaves[i][31] = byte(i + 1)
+	}
+	return leaves
+}
+
+func TestTreeProofRoundTrip(t *testing.T) {
+	leaves := testLeaves(8)
+	tree, err := merkle.NewTree(leaves)
+	if err != nil {
+		t.Fatalf("NewTree: %v", err)
+	}
+	root := tree.Root()
+
+	for i := range leaves {
+		branch, err := tree.Proof(uint64(8 + i))
+		if err != nil {
+			t.Fatalf("Proof(%d): %v", i, err)
+		}
+		if err := merkle.VerifyProof(root, uint64(8+i), branch, leaves[i]); err != nil {
+			t.Fatalf("VerifyProof(%d): %v", i, err)
+		}
+	}
+}
+
+func TestVerifyProofRejectsTamperedBranch(t *testing.T) {
+	leaves := testLeaves(8)
+	tree, err := merkle.NewTree(leaves)
+	if err != nil {
+		t.Fatalf("NewTree: %v", err)
+	}
+	root := tree.Root()
+
+	branch, err := tree.Proof(8)
+	if err != nil {
+		t.Fatalf("Proof: %v", err)
+	}
+	branch[0][0] ^= 0xff
+
+	if err := merkle.VerifyProof(root, 8, branch, leaves[0]); err == nil {
+		t.Fatal("expected an error for a tampered branch")
+	}
+}
+
+func TestVerifyProofRejectsWrongRoot(t *testing.T) {
+	leaves := testLeaves(8)
+	tree, err := merkle.NewTree(leaves)
+	if err != nil {
+		t.Fatalf("NewTree: %v", err)
+	}
+
+	branch, err := tree.Proof(8)
+	if err != nil {
+		t.Fatalf("Proof: %v", err)
+	}
+
+	var wrongRoot common.Root
+	wrongRoot[0] = 0x01
+	if err := merkle.VerifyProof(wrongRoot, 8, branch, leaves[0]); err == nil {
+		t.Fatal("expected an error for a mismatched root")
+	}
+}
+
+func TestVerifyProofRejectsTamperedLeaf(t *testing.T) {
+	leaves := testLeaves(8)
+	tree, err := merkle.NewTree(leaves)
+	if err != nil {
+		t.Fatalf("NewTree: %v", err)
+	}
+	root := tree.Root()
+
+	branch, err := tree.Proof(8)
+	if err != nil {
+		t.Fatalf("Proof: %v", err)
+	}
+
+	forgedLeaf := leaves[0]
+	forgedLeaf[0] ^= 0xff
+	if err := merkle.VerifyProof(root, 8, branch, forgedLeaf); err == nil {
+		t.Fatal("expected an error for a forged leaf")
+	}
+}
+
+func TestTreeMultiProofRoundTrip(t *testing.T) {
+	leaves := testLeaves(8)
+	tree, err := merkle.NewTree(leaves)
+	if err != nil {
+		t.Fatalf("NewTree: %v", err)
+	}
+	root := tree.Root()
+
+	indices := []uint64{8, 10, 15}
+	wantLeaves := []merkle.Value{leaves[0], leaves[2], leaves[7]}
+
+	branch, err := tree.MultiProof(indices)
+	if err != nil {
+		t.Fatalf("MultiProof: %v", err)
+	}
+	if err := merkle.VerifyMultiProof(root, indices, branch, wantLeaves); err != nil {
+		t.Fatalf("VerifyMultiProof: %v", err)
+	}
+}
+
+func TestVerifyMultiProofRejectsMismatchedLengths(t *testing.T) {
+	leaves := testLeaves(8)
+	tree, err := merkle.NewTree(leaves)
+	if err != nil {
+		t.Fatalf("NewTree: %v", err)
+	}
+	root := tree.Root()
+
+	indices := []uint64{8, 10}
+	branch, err := tree.MultiProof(indices)
+	if err != nil {
+		t.Fatalf("MultiProof: %v", err)
+	}
+
+	err = merkle.VerifyMultiProof(root, indices, branch, []merkle.Value{leaves[0]})
+	if err == nil {
+		t.Fatal("expected an error for mismatched indices/leaves lengths")
+	}
+}
+
+func TestVerifyMultiProofRejectsMismatchedBranchLength(t *testing.T) {
+	leaves := testLeaves(8)
+	tree, err := merkle.NewTree(leaves)
+	if err != nil {
+		t.Fatalf("NewTree: %v", err)
+	}
+	root := tree.Root()
+
+	indices := []uint64{8, 10}
+	branch, err := tree.MultiProof(indices)
+	if err != nil {
+		t.Fatalf("MultiProof: %v", err)
+	}
+
+	err = merkle.VerifyMultiProof(
+		root, indices, branch[:len(branch)-1], []merkle.Value{leaves[0], leaves[2]},
+	)
+	if err == nil {
+		t.Fatal("expected an error for a short branch")
+	}
+}
+
+func TestVerifyMultiProofRejectsTamperedLeaf(t *testing.T) {
+	leaves := testLeaves(8)
+	tree, err := merkle.NewTree(leaves)
+	if err != nil {
+		t.Fatalf("NewTree: %v", err)
+	}
+	root := tree.Root()
+
+	indices := []uint64{8, 10}
+	branch, err := tree.MultiProof(indices)
+	if err != nil {
+		t.Fatalf("MultiProof: %v", err)
+	}
+
+	forged := leaves[2]
+	forged[0] ^= 0xff
+	err = merkle.VerifyMultiProof(root, indices, branch, []merkle.Value{leaves[0], forged})
+	if err == nil {
+		t.Fatal("expected an error for a forged leaf in a multiproof")
+	}
+}
+
+func TestNewTreeRejectsNonPowerOfTwo(t *testing.T) {
+	if _, err := merkle.NewTree(testLeaves(3)); err == nil {
+		t.Fatal("expected an error for a non-power-of-two leaf count")
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"math/bits"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// Tree is a full binary Merkle tree built over a fixed-size slice of leaves,
+// indexed by generalized index (root is 1). It is a builder only: proof
+// verification lives in VerifyProof / VerifyMultiProof and does not depend
+// on Tree.
+type Tree struct {
+	nodes map[uint64]Value
+	depth uint64
+}
+
+// NewTree builds a Tree from leaves. len(leaves) must be a power of two.
+func NewTree(leaves []Value) (*Tree, error) {
+	n := uint64(len(leaves))
+	if n == 0 || n&(n-1) != 0 {
+		return nil, ErrNotPowerOfTwo
+	}
+	depth := uint64(bits.Len64(n - 1))
+	base := uint64(1) << depth
+
+	nodes := make(map[uint64]Value, 2*n)
+	for i, leaf := range leaves {
+		nodes[base+uint64(i)] = leaf
+	}
+	for level := depth; level > 0; level-- {
+		count := uint64(1) << (level - 1)
+		for i := uint64(0); i < count; i++ {
+			idx := count + i
+			nodes[idx] = hashPair(nodes[idx*2], nodes[idx*2+1])
+		}
+	}
+	return &Tree{nodes: nodes, depth: depth}, nil
+}
+
+// Root returns the root of the tree.
+func (t *Tree) Root() common.Root {
+	root := t.nodes[1]
+	return common.Root(root)
+}
+
+// Proof returns the single-element proof branch for index, from leaf to
+// root.
+func (t *Tree) Proof(index uint64) ([]Value, error) {
+	branch := make([]Value, 0, t.depth)
+	for _, gIndex := range branchIndices(index) {
+		node, ok := t.nodes[gIndex]
+		if !ok {
+			return nil, ErrIndexOutOfRange
+		}
+		branch = append(branch, node)
+	}
+	return branch, nil
+}
+
+// MultiProof returns the minimal branch needed to verify every leaf at
+// indices via VerifyMultiProof.
+func (t *Tree) MultiProof(indices []uint64) ([]Value, error) {
+	helpers := helperIndices(indices)
+	branch := make([]Value, len(helpers))
+	for i, gIndex := range helpers {
+		node, ok := t.nodes[gIndex]
+		if !ok {
+			return nil, errors.Wrapf(ErrIndexOutOfRange, "generalized index %d", gIndex)
+		}
+		branch[i] = node
+	}
+	return branch, nil
+}
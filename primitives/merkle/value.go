@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package merkle implements binary Merkle proof verification against
+// generalized indices, so that light-client-style consumers can verify
+// individual beacon state / block fields without holding the full state.
+package merkle
+
+import (
+	stdbytes "bytes"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+)
+
+// ValueSize is the size, in bytes, of a single Merkle tree node.
+const ValueSize = 32
+
+// Value represents a single node (leaf or internal) of a Merkle tree.
+//
+// It mirrors common.Root's hex (un)marshaling so that branches and leaves
+// read the same way in JSON payloads as any other 32-byte root.
+type Value [ValueSize]byte
+
+// NewValueFromHex creates a new Value from a hex string.
+//
+// Errors if:
+// - input is not prefixed with "0x".
+// - input is not valid hex of 32 bytes.
+func NewValueFromHex(input string) (Value, error) {
+	val, err := hex.ToBytes(input)
+	if err != nil {
+		return Value{}, err
+	}
+	if len(val) != ValueSize {
+		return Value{}, bytes.ErrIncorrectLength
+	}
+	return Value(val), nil
+}
+
+// NewValueFromBytes creates a new Value from a byte slice.
+func NewValueFromBytes(input []byte) Value {
+	var v Value
+	copy(v[:], input)
+	return v
+}
+
+// Equals returns true if the two values are equal.
+func (v Value) Equals(other Value) bool {
+	return stdbytes.Equal(v[:], other[:])
+}
+
+// Hex converts a Value to a hex string.
+func (v Value) Hex() string { return hex.EncodeBytes(v[:]) }
+
+// String implements the stringer interface.
+func (v Value) String() string {
+	return v.Hex()
+}
+
+// MarshalText returns the hex representation of v.
+func (v Value) MarshalText() ([]byte, error) {
+	return []byte(v.Hex()), nil
+}
+
+// UnmarshalText parses a Value in hex syntax.
+func (v *Value) UnmarshalText(input []byte) error {
+	var err error
+	*v, err = NewValueFromHex(string(input))
+	return err
+}
+
+// MarshalJSON returns the JSON representation of v.
+func (v Value) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Hex())
+}
+
+// UnmarshalJSON parses a Value in hex syntax.
+//
+// NOTE: Enforces the input to include any extra character in the first and
+// last position. Technically this is used to remove the quote `"`.
+func (v *Value) UnmarshalJSON(input []byte) error {
+	if len(input) <= 1 {
+		return errors.Wrapf(
+			bytes.ErrIncorrectLength, "input length (%d) is too small", len(input),
+		)
+	}
+	return v.UnmarshalText(input[1 : len(input)-1])
+}
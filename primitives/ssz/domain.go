@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ssz
+
+import "github.com/berachain/beacon-kit/primitives/common"
+
+// ComputeDomain derives a signing domain:
+//
+//	domain = domain_type || fork_data_root[:28]
+//	fork_data_root = hash_tree_root(ForkData(forkVersion, genesisValidatorsRoot))
+//
+// fork_data_root is computed via common.ComputeForkDataRoot, the same
+// primitive ComputeForkDigest uses, so the two stay consistent.
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_domain
+func ComputeDomain(
+	domainType common.DomainType,
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.Domain {
+	forkDataRoot := common.ComputeForkDataRoot(forkVersion, genesisValidatorsRoot)
+
+	var domain common.Domain
+	copy(domain[:len(domainType)], domainType[:])
+	copy(domain[len(domainType):], forkDataRoot[:28])
+	return domain
+}
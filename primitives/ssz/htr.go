@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package ssz provides the hash-tree-root and signing-root primitives that
+// every signable SSZ object (blocks, attestations, voluntary exits, ...)
+// should go through, so proposer signature verification uses a single
+// well-tested code path instead of per-object custom variants.
+//
+// NOTE: the concrete block / attestation / voluntary-exit types this is
+// meant to replace per-object HTR variants for do not exist in this tree
+// yet; SSZObject's method name matches the HashTreeRoot() ([32]byte, error)
+// signature the project's fastssz codegen already produces, so those types
+// satisfy it without modification once they land.
+package ssz
+
+import (
+	"crypto/sha256"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// SSZObject is any type that knows how to compute its own SSZ hash tree
+// root. Types generated by the project's SSZ codegen satisfy this directly.
+type SSZObject interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+// HashTreeRoot returns the canonical SSZ hash tree root of obj.
+func HashTreeRoot(obj SSZObject) (common.Root, error) {
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	return common.Root(root), nil
+}
+
+// SigningRoot returns the root actually signed over for obj under domain:
+//
+//	signing_root = SHA256(hash_tree_root(obj) || domain)
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_signing_root
+func SigningRoot(obj SSZObject, domain common.Domain) (common.Root, error) {
+	objRoot, err := HashTreeRoot(obj)
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	h := sha256.New()
+	h.Write(objRoot[:])
+	h.Write(domain[:])
+
+	var signingRoot common.Root
+	copy(signingRoot[:], h.Sum(nil))
+	return signingRoot, nil
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ssz_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/ssz"
+)
+
+// stubSSZObject is a minimal SSZObject whose hash tree root is fixed, so
+// SigningRoot can be tested without any of the not-yet-existing block /
+// attestation / voluntary-exit types.
+type stubSSZObject struct {
+	root [32]byte
+	err  error
+}
+
+func (s stubSSZObject) HashTreeRoot() ([32]byte, error) {
+	return s.root, s.err
+}
+
+func TestHashTreeRoot(t *testing.T) {
+	obj := stubSSZObject{root: [32]byte{0x01, 0x02, 0x03}}
+
+	root, err := ssz.HashTreeRoot(obj)
+	if err != nil {
+		t.Fatalf("HashTreeRoot: %v", err)
+	}
+	if root != common.Root(obj.root) {
+		t.Fatalf("got %s, want %s", root, common.Root(obj.root))
+	}
+}
+
+func TestHashTreeRootPropagatesError(t *testing.T) {
+	wantErr := errors.New("boom")
+	obj := stubSSZObject{err: wantErr}
+
+	if _, err := ssz.HashTreeRoot(obj); !errors.Is(err, wantErr) {
+		t.Fatalf("got error %v, want %v", err, wantErr)
+	}
+}
+
+// TestComputeDomain checks ComputeDomain against a known-answer vector
+// computed independently here: domain_type || fork_data_root[:28], where
+// fork_data_root = SHA256(version zero-padded to 32 bytes || genesis_validators_root).
+func TestComputeDomain(t *testing.T) {
+	domainType := common.DomainType{0xde, 0xad, 0xbe, 0xef}
+	version := common.Version{0x01, 0x02, 0x03, 0x04}
+	var genesisValidatorsRoot common.Root
+	for i := range genesisValidatorsRoot {
+		genesisValidatorsRoot[i] = byte(i)
+	}
+
+	var versionLeaf [32]byte
+	copy(versionLeaf[:], version[:])
+	h := sha256.New()
+	h.Write(versionLeaf[:])
+	h.Write(genesisValidatorsRoot[:])
+	forkDataRoot := h.Sum(nil)
+
+	var want common.Domain
+	copy(want[:len(domainType)], domainType[:])
+	copy(want[len(domainType):], forkDataRoot[:28])
+
+	got := ssz.ComputeDomain(domainType, version, genesisValidatorsRoot)
+	if got != want {
+		t.Fatalf("got domain %x, want %x", got, want)
+	}
+}
+
+// TestSigningRoot checks SigningRoot against a known-answer vector computed
+// independently here: SHA256(hash_tree_root(obj) || domain).
+func TestSigningRoot(t *testing.T) {
+	obj := stubSSZObject{root: [32]byte{0xaa, 0xbb, 0xcc}}
+	var domain common.Domain
+	for i := range domain {
+		domain[i] = byte(i + 1)
+	}
+
+	h := sha256.New()
+	h.Write(obj.root[:])
+	h.Write(domain[:])
+	want := common.NewRootFromBytes(h.Sum(nil))
+
+	got, err := ssz.SigningRoot(obj, domain)
+	if err != nil {
+		t.Fatalf("SigningRoot: %v", err)
+	}
+	if got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestSigningRootPropagatesError(t *testing.T) {
+	wantErr := errors.New("boom")
+	obj := stubSSZObject{err: wantErr}
+
+	if _, err := ssz.SigningRoot(obj, common.Domain{}); !errors.Is(err, wantErr) {
+		t.Fatalf("got error %v, want %v", err, wantErr)
+	}
+}